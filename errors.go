@@ -0,0 +1,62 @@
+package pocket
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	//xErrorCodeHeader carries Pocket's numeric error code on non-2XX responses
+	xErrorCodeHeader = "X-Error-Code"
+	//xSourceHeader carries an identifier for the request, useful when reporting issues to Pocket
+	xSourceHeader = "X-Source"
+)
+
+// APIError is returned whenever Pocket responds with a non-2XX status code. It carries
+// the HTTP status alongside Pocket's own X-Error-Code/X-Error headers, so callers can
+// branch on errors.Is/As instead of matching error strings.
+type APIError struct {
+	StatusCode int
+	Code       int
+	Message    string
+	Limit      *RateLimit
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("pocket: api error %d (http status %d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// Is reports whether target is a sentinel APIError keyed to the same Code, so that
+// errors.Is(err, pocket.ErrRateLimited) works after wrapping.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+
+	return e.Code == t.Code
+}
+
+// Sentinel errors keyed to Pocket's documented X-Error-Code values.
+var (
+	ErrInvalidConsumerKey = &APIError{Code: 132, Message: "invalid consumer key"}
+	ErrInvalidAccessToken = &APIError{Code: 138, Message: "invalid access token"}
+	ErrMissingPermission  = &APIError{Code: 152, Message: "missing permission"}
+	ErrServerBusy         = &APIError{Code: 198, Message: "pocket server busy"}
+	ErrRateLimited        = &APIError{Code: 199, Message: "rate limit exceeded"}
+)
+
+// newAPIError builds an APIError from a non-2XX response.
+func newAPIError(resp *http.Response, limit *RateLimit) *APIError {
+	code, _ := strconv.Atoi(resp.Header.Get(xErrorCodeHeader))
+
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Code:       code,
+		Message:    resp.Header.Get(xErrorHeader),
+		Limit:      limit,
+		RequestID:  resp.Header.Get(xSourceHeader),
+	}
+}
@@ -0,0 +1,23 @@
+package pocket
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	err := &APIError{Code: 199, StatusCode: 403, Message: "rate limit exceeded"}
+
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.False(t, errors.Is(err, ErrInvalidAccessToken))
+}
+
+func TestAPIError_Error(t *testing.T) {
+	err := &APIError{Code: 138, StatusCode: 401, Message: "invalid access token"}
+
+	assert.Contains(t, err.Error(), "138")
+	assert.Contains(t, err.Error(), "401")
+	assert.Contains(t, err.Error(), "invalid access token")
+}
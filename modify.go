@@ -0,0 +1,363 @@
+package pocket
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const endpointSend = "/send"
+
+// defaultMaxBatchSize bounds how many actions Modify sends per request by default.
+const defaultMaxBatchSize = 20
+
+const (
+	actionAdd         = "add"
+	actionArchive     = "archive"
+	actionReadd       = "readd"
+	actionFavorite    = "favorite"
+	actionUnfavorite  = "unfavorite"
+	actionDelete      = "delete"
+	actionTagsAdd     = "tags_add"
+	actionTagsRemove  = "tags_remove"
+	actionTagsReplace = "tags_replace"
+	actionTagsClear   = "tags_clear"
+	actionTagRename   = "tag_rename"
+	actionTagDelete   = "tag_delete"
+)
+
+// Action is a single operation understood by the /v3/send endpoint.
+type Action interface {
+	toRequest() actionRequest
+}
+
+type actionRequest struct {
+	Action string `json:"action"`
+	ItemID string `json:"item_id,omitempty"`
+	RefID  string `json:"ref_id,omitempty"`
+	Tags   string `json:"tags,omitempty"`
+	Tag    string `json:"tag,omitempty"`
+	OldTag string `json:"old_tag,omitempty"`
+	NewTag string `json:"new_tag,omitempty"`
+	Time   string `json:"time,omitempty"`
+	Title  string `json:"title,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// AddAction adds a new URL to the user's list, optionally attributing it to an existing
+// item via RefID.
+type AddAction struct {
+	ItemID string
+	RefID  string
+	Tags   []string
+	Title  string
+	URL    string
+}
+
+func (a AddAction) toRequest() actionRequest {
+	return actionRequest{Action: actionAdd, ItemID: a.ItemID, RefID: a.RefID, Tags: joinTags(a.Tags), Title: a.Title, URL: a.URL}
+}
+
+// ArchiveAction marks an item as archived.
+type ArchiveAction struct {
+	ItemID string
+}
+
+func (a ArchiveAction) toRequest() actionRequest {
+	return actionRequest{Action: actionArchive, ItemID: a.ItemID}
+}
+
+// ReaddAction moves an archived or deleted item back into the user's list.
+type ReaddAction struct {
+	ItemID string
+}
+
+func (a ReaddAction) toRequest() actionRequest {
+	return actionRequest{Action: actionReadd, ItemID: a.ItemID}
+}
+
+// FavoriteAction marks an item as a favorite.
+type FavoriteAction struct {
+	ItemID string
+}
+
+func (a FavoriteAction) toRequest() actionRequest {
+	return actionRequest{Action: actionFavorite, ItemID: a.ItemID}
+}
+
+// UnfavoriteAction removes an item's favorite status.
+type UnfavoriteAction struct {
+	ItemID string
+}
+
+func (a UnfavoriteAction) toRequest() actionRequest {
+	return actionRequest{Action: actionUnfavorite, ItemID: a.ItemID}
+}
+
+// DeleteAction permanently removes an item from the user's list.
+type DeleteAction struct {
+	ItemID string
+}
+
+func (a DeleteAction) toRequest() actionRequest {
+	return actionRequest{Action: actionDelete, ItemID: a.ItemID}
+}
+
+// TagsAddAction attaches one or more tags to an item, leaving its existing tags in place.
+type TagsAddAction struct {
+	ItemID string
+	Tags   []string
+}
+
+func (a TagsAddAction) toRequest() actionRequest {
+	return actionRequest{Action: actionTagsAdd, ItemID: a.ItemID, Tags: joinTags(a.Tags)}
+}
+
+// TagsRemoveAction removes one or more tags from an item.
+type TagsRemoveAction struct {
+	ItemID string
+	Tags   []string
+}
+
+func (a TagsRemoveAction) toRequest() actionRequest {
+	return actionRequest{Action: actionTagsRemove, ItemID: a.ItemID, Tags: joinTags(a.Tags)}
+}
+
+// TagsReplaceAction replaces all of an item's tags with the given set.
+type TagsReplaceAction struct {
+	ItemID string
+	Tags   []string
+}
+
+func (a TagsReplaceAction) toRequest() actionRequest {
+	return actionRequest{Action: actionTagsReplace, ItemID: a.ItemID, Tags: joinTags(a.Tags)}
+}
+
+// TagsClearAction removes all tags from an item.
+type TagsClearAction struct {
+	ItemID string
+}
+
+func (a TagsClearAction) toRequest() actionRequest {
+	return actionRequest{Action: actionTagsClear, ItemID: a.ItemID}
+}
+
+// TagRenameAction renames a tag across the whole list.
+type TagRenameAction struct {
+	OldTag string
+	NewTag string
+}
+
+func (a TagRenameAction) toRequest() actionRequest {
+	return actionRequest{Action: actionTagRename, OldTag: a.OldTag, NewTag: a.NewTag}
+}
+
+// TagDeleteAction removes a tag from every item it is attached to.
+type TagDeleteAction struct {
+	Tag string
+}
+
+func (a TagDeleteAction) toRequest() actionRequest {
+	return actionRequest{Action: actionTagDelete, Tag: a.Tag}
+}
+
+func joinTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+
+	return strings.Join(tags, ",")
+}
+
+// ModifyInput holds the access token and batch of actions submitted to Modify.
+type ModifyInput struct {
+	AccessToken  string
+	Actions      []Action
+	MaxBatchSize int
+}
+
+func (i ModifyInput) validate() error {
+	if i.AccessToken == "" {
+		return errors.New("access token is empty")
+	}
+	if len(i.Actions) == 0 {
+		return errors.New("actions are empty")
+	}
+
+	return nil
+}
+
+func (i ModifyInput) batchSize() int {
+	if i.MaxBatchSize > 0 {
+		return i.MaxBatchSize
+	}
+
+	return defaultMaxBatchSize
+}
+
+type modifyRequest struct {
+	ConsumerKey string          `json:"consumer_key"`
+	AccessToken string          `json:"access_token"`
+	Actions     []actionRequest `json:"actions"`
+}
+
+// ActionError describes a single action's failure inside a Modify response.
+type ActionError struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    int    `json:"code"`
+}
+
+// ModifyResponse reports the per-action results of a Modify call, index-aligned with the
+// submitted actions.
+type ModifyResponse struct {
+	Status        int            `json:"status"`
+	ActionResults []bool         `json:"action_results"`
+	ActionErrors  []*ActionError `json:"action_errors"`
+}
+
+// Modify submits a batch of actions (archive, favorite, tag, delete, ...) against the
+// user's Pocket list via the /v3/send endpoint. On a partial batch failure it returns
+// the results accumulated so far alongside the error.
+func (c *Client) Modify(ctx context.Context, input ModifyInput) (*ModifyResponse, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	batchSize := input.batchSize()
+
+	result := &ModifyResponse{}
+
+	for start := 0; start < len(input.Actions); start += batchSize {
+		end := start + batchSize
+		if end > len(input.Actions) {
+			end = len(input.Actions)
+		}
+
+		resp, err := c.sendActions(ctx, input.AccessToken, input.Actions[start:end])
+		if err != nil {
+			return result, err
+		}
+
+		result.Status = resp.Status
+		result.ActionResults = append(result.ActionResults, resp.ActionResults...)
+		result.ActionErrors = append(result.ActionErrors, resp.ActionErrors...)
+	}
+
+	return result, nil
+}
+
+func (c *Client) sendActions(ctx context.Context, accessToken string, actions []Action) (*ModifyResponse, error) {
+	reqs := make([]actionRequest, 0, len(actions))
+	for _, a := range actions {
+		reqs = append(reqs, a.toRequest())
+	}
+
+	req := modifyRequest{
+		ConsumerKey: c.consumerKey,
+		AccessToken: accessToken,
+		Actions:     reqs,
+	}
+
+	b, err := c.doHTTP(ctx, endpointSend, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ModifyResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, errors.WithMessage(err, "failed to parse response body")
+	}
+
+	return &resp, nil
+}
+
+// ActionBuilder builds a batch of actions for Modify using a fluent interface.
+type ActionBuilder struct {
+	client  *Client
+	actions []Action
+}
+
+// NewActions starts building a new batch of actions to submit via Modify.
+func (c *Client) NewActions() *ActionBuilder {
+	return &ActionBuilder{client: c}
+}
+
+// Add queues an AddAction.
+func (b *ActionBuilder) Add(url, title string, tags ...string) *ActionBuilder {
+	b.actions = append(b.actions, AddAction{URL: url, Title: title, Tags: tags})
+	return b
+}
+
+// Archive queues an ArchiveAction for itemID.
+func (b *ActionBuilder) Archive(itemID string) *ActionBuilder {
+	b.actions = append(b.actions, ArchiveAction{ItemID: itemID})
+	return b
+}
+
+// Readd queues a ReaddAction for itemID.
+func (b *ActionBuilder) Readd(itemID string) *ActionBuilder {
+	b.actions = append(b.actions, ReaddAction{ItemID: itemID})
+	return b
+}
+
+// Favorite queues a FavoriteAction for itemID.
+func (b *ActionBuilder) Favorite(itemID string) *ActionBuilder {
+	b.actions = append(b.actions, FavoriteAction{ItemID: itemID})
+	return b
+}
+
+// Unfavorite queues an UnfavoriteAction for itemID.
+func (b *ActionBuilder) Unfavorite(itemID string) *ActionBuilder {
+	b.actions = append(b.actions, UnfavoriteAction{ItemID: itemID})
+	return b
+}
+
+// Delete queues a DeleteAction for itemID.
+func (b *ActionBuilder) Delete(itemID string) *ActionBuilder {
+	b.actions = append(b.actions, DeleteAction{ItemID: itemID})
+	return b
+}
+
+// TagsAdd queues a TagsAddAction for itemID.
+func (b *ActionBuilder) TagsAdd(itemID string, tags ...string) *ActionBuilder {
+	b.actions = append(b.actions, TagsAddAction{ItemID: itemID, Tags: tags})
+	return b
+}
+
+// TagsRemove queues a TagsRemoveAction for itemID.
+func (b *ActionBuilder) TagsRemove(itemID string, tags ...string) *ActionBuilder {
+	b.actions = append(b.actions, TagsRemoveAction{ItemID: itemID, Tags: tags})
+	return b
+}
+
+// TagsReplace queues a TagsReplaceAction for itemID.
+func (b *ActionBuilder) TagsReplace(itemID string, tags ...string) *ActionBuilder {
+	b.actions = append(b.actions, TagsReplaceAction{ItemID: itemID, Tags: tags})
+	return b
+}
+
+// TagsClear queues a TagsClearAction for itemID.
+func (b *ActionBuilder) TagsClear(itemID string) *ActionBuilder {
+	b.actions = append(b.actions, TagsClearAction{ItemID: itemID})
+	return b
+}
+
+// TagRename queues a TagRenameAction.
+func (b *ActionBuilder) TagRename(oldTag, newTag string) *ActionBuilder {
+	b.actions = append(b.actions, TagRenameAction{OldTag: oldTag, NewTag: newTag})
+	return b
+}
+
+// TagDelete queues a TagDeleteAction.
+func (b *ActionBuilder) TagDelete(tag string) *ActionBuilder {
+	b.actions = append(b.actions, TagDeleteAction{Tag: tag})
+	return b
+}
+
+// Do submits the accumulated actions to Pocket via Modify.
+func (b *ActionBuilder) Do(ctx context.Context, accessToken string) (*ModifyResponse, error) {
+	return b.client.Modify(ctx, ModifyInput{AccessToken: accessToken, Actions: b.actions})
+}
@@ -0,0 +1,165 @@
+package pocket
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Modify(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input ModifyInput
+	}
+	tests := []struct {
+		name               string
+		args               args
+		expectedStatusCode int
+		expectedResponse   string
+		want               *ModifyResponse
+		wantErr            bool
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: context.Background(),
+				input: ModifyInput{
+					AccessToken: "token",
+					Actions:     []Action{ArchiveAction{ItemID: "123"}, FavoriteAction{ItemID: "456"}},
+				},
+			},
+			expectedStatusCode: 200,
+			expectedResponse:   `{"status": 1, "action_results": [true, true], "action_errors": [null, null]}`,
+			want: &ModifyResponse{
+				Status:        1,
+				ActionResults: []bool{true, true},
+				ActionErrors:  []*ActionError{nil, nil},
+			},
+		},
+		{
+			name: "Empty access token",
+			args: args{
+				ctx: context.Background(),
+				input: ModifyInput{
+					Actions: []Action{ArchiveAction{ItemID: "123"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Empty actions",
+			args: args{
+				ctx: context.Background(),
+				input: ModifyInput{
+					AccessToken: "token",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Non-2XX-Response",
+			args: args{
+				ctx: context.Background(),
+				input: ModifyInput{
+					AccessToken: "token",
+					Actions:     []Action{ArchiveAction{ItemID: "123"}},
+				},
+			},
+			expectedStatusCode: 400,
+			wantErr:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, tt.expectedStatusCode, "/v3/send", tt.expectedResponse)
+
+			got, err := c.Modify(tt.args.ctx, tt.args.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_Modify_Batching(t *testing.T) {
+	var calls int
+
+	c := &Client{
+		client: &http.Client{
+			Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				calls++
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"status": 1, "action_results": [true], "action_errors": [null]}`)),
+				}, nil
+			}),
+		},
+		consumerKey: "key",
+		baseURL:     host,
+	}
+
+	actions := make([]Action, 3)
+	for i := range actions {
+		actions[i] = ArchiveAction{ItemID: "123"}
+	}
+
+	got, err := c.Modify(context.Background(), ModifyInput{AccessToken: "token", Actions: actions, MaxBatchSize: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []bool{true, true, true}, got.ActionResults)
+}
+
+func TestClient_Modify_PartialBatchFailure(t *testing.T) {
+	var calls int
+
+	c := &Client{
+		client: &http.Client{
+			Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				calls++
+				if calls == 2 {
+					return &http.Response{
+						StatusCode: 400,
+						Body:       ioutil.NopCloser(strings.NewReader("")),
+					}, nil
+				}
+
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"status": 1, "action_results": [true], "action_errors": [null]}`)),
+				}, nil
+			}),
+		},
+		consumerKey: "key",
+		baseURL:     host,
+	}
+
+	actions := make([]Action, 3)
+	for i := range actions {
+		actions[i] = ArchiveAction{ItemID: "123"}
+	}
+
+	got, err := c.Modify(context.Background(), ModifyInput{AccessToken: "token", Actions: actions, MaxBatchSize: 1})
+
+	assert.Error(t, err)
+	assert.Equal(t, 2, calls)
+	assert.NotNil(t, got)
+	assert.Equal(t, []bool{true}, got.ActionResults)
+}
+
+func TestActionBuilder_Do(t *testing.T) {
+	c := newClient(t, 200, "/v3/send", `{"status": 1, "action_results": [true, true], "action_errors": [null, null]}`)
+
+	got, err := c.NewActions().Archive("123").TagsAdd("123", "go", "sdk").Do(context.Background(), "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, true}, got.ActionResults)
+}
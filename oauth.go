@@ -0,0 +1,130 @@
+package pocket
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	stateQueryParam  = "state"
+	authorizeBaseURL = "https://getpocket.com/auth/authorize"
+)
+
+// GetAuthorizationURLWithState behaves like GetAuthorizationURL but also embeds an opaque
+// state parameter that Pocket echoes back on the redirect, binding the round-trip to a
+// specific user session.
+func (c *Client) GetAuthorizationURLWithState(requestToken, redirectURL, state string) (string, error) {
+	if requestToken == "" || redirectURL == "" {
+		return "", errors.New("empty request token")
+	}
+	if state == "" {
+		return "", errors.New("empty state")
+	}
+
+	q := url.Values{}
+	q.Set("request_token", requestToken)
+	q.Set("redirect_uri", redirectURL)
+	q.Set(stateQueryParam, state)
+
+	return authorizeBaseURL + "?" + q.Encode(), nil
+}
+
+// StateStore binds an opaque OAuth state value to the request token it was issued for.
+// Consume must be single-use: a state must not be usable twice.
+type StateStore interface {
+	Save(state, requestToken string) error
+	Consume(state string) (requestToken string, err error)
+}
+
+type memoryStateEntry struct {
+	requestToken string
+	expiresAt    time.Time
+}
+
+// MemoryStateStore is a StateStore backed by an in-memory map, suitable for a single process.
+type MemoryStateStore struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	items map[string]memoryStateEntry
+}
+
+// NewMemoryStateStore creates a MemoryStateStore whose entries expire after ttl.
+func NewMemoryStateStore(ttl time.Duration) *MemoryStateStore {
+	return &MemoryStateStore{
+		ttl:   ttl,
+		items: make(map[string]memoryStateEntry),
+	}
+}
+
+// Save stores requestToken under state until ttl elapses.
+func (s *MemoryStateStore) Save(state, requestToken string) error {
+	if state == "" {
+		return errors.New("empty state")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[state] = memoryStateEntry{
+		requestToken: requestToken,
+		expiresAt:    time.Now().Add(s.ttl),
+	}
+
+	return nil
+}
+
+// Consume looks up and removes the request token saved under state, failing if state is
+// unknown, already consumed, or expired.
+func (s *MemoryStateStore) Consume(state string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.items[state]
+	delete(s.items, state)
+
+	if !ok {
+		return "", errors.New("unknown or already used state")
+	}
+
+	if time.Now().After(entry.expiresAt) {
+		return "", errors.New("state has expired")
+	}
+
+	return entry.requestToken, nil
+}
+
+// CallbackHandler returns an http.Handler for your OAuth redirect URL. It validates the
+// "state" query parameter against store, exchanges the bound request token for an access
+// token via Authorize, and reports the outcome via onSuccess or onError.
+func (c *Client) CallbackHandler(
+	store StateStore,
+	onSuccess func(w http.ResponseWriter, r *http.Request, resp *AuthorizeResponse),
+	onError func(w http.ResponseWriter, r *http.Request, err error),
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := r.URL.Query().Get(stateQueryParam)
+		if state == "" {
+			onError(w, r, errors.New("missing state parameter"))
+			return
+		}
+
+		requestToken, err := store.Consume(state)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+
+		resp, err := c.Authorize(r.Context(), requestToken)
+		if err != nil {
+			onError(w, r, err)
+			return
+		}
+
+		onSuccess(w, r, resp)
+	})
+}
@@ -0,0 +1,167 @@
+package pocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAuthorizationURLWithState(t *testing.T) {
+	type args struct {
+		requestToken string
+		redirectURL  string
+		state        string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			args: args{
+				requestToken: "qwe-rty-123",
+				redirectURL:  "http://localhost:80/",
+				state:        "xyz",
+			},
+			want: "https://getpocket.com/auth/authorize?redirect_uri=http%3A%2F%2Flocalhost%3A80%2F&request_token=qwe-rty-123&state=xyz",
+		},
+		{
+			name: "Redirect URL with its own query string",
+			args: args{
+				requestToken: "reqtok",
+				redirectURL:  "http://localhost/cb?foo=bar&next=home",
+				state:        "xyz123",
+			},
+			want: "https://getpocket.com/auth/authorize?redirect_uri=http%3A%2F%2Flocalhost%2Fcb%3Ffoo%3Dbar%26next%3Dhome&request_token=reqtok&state=xyz123",
+		},
+		{
+			name: "Empty state",
+			args: args{
+				requestToken: "qwe-rty-123",
+				redirectURL:  "http://localhost:80/",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Empty request token",
+			args: args{
+				redirectURL: "http://localhost:80/",
+				state:       "xyz",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{}
+			got, err := c.GetAuthorizationURLWithState(tt.args.requestToken, tt.args.redirectURL, tt.args.state)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+
+				gotURL, err := url.Parse(got)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.args.redirectURL, gotURL.Query().Get("redirect_uri"))
+			}
+		})
+	}
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	t.Run("consume returns the saved request token", func(t *testing.T) {
+		store := NewMemoryStateStore(time.Minute)
+
+		assert.NoError(t, store.Save("state-1", "token-1"))
+
+		got, err := store.Consume("state-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "token-1", got)
+	})
+
+	t.Run("consume is single-use", func(t *testing.T) {
+		store := NewMemoryStateStore(time.Minute)
+
+		assert.NoError(t, store.Save("state-1", "token-1"))
+		_, err := store.Consume("state-1")
+		assert.NoError(t, err)
+
+		_, err = store.Consume("state-1")
+		assert.Error(t, err)
+	})
+
+	t.Run("consume rejects expired state", func(t *testing.T) {
+		store := NewMemoryStateStore(-time.Minute)
+
+		assert.NoError(t, store.Save("state-1", "token-1"))
+
+		_, err := store.Consume("state-1")
+		assert.Error(t, err)
+	})
+
+	t.Run("save rejects empty state", func(t *testing.T) {
+		store := NewMemoryStateStore(time.Minute)
+		assert.Error(t, store.Save("", "token-1"))
+	})
+}
+
+func TestClient_CallbackHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		store := NewMemoryStateStore(time.Minute)
+		assert.NoError(t, store.Save("state-1", "token-1"))
+
+		c := newClient(t, 200, "/v3/oauth/authorize", "access_token=qwe-rty-123&username=testuser")
+
+		var got *AuthorizeResponse
+		handler := c.CallbackHandler(store,
+			func(w http.ResponseWriter, r *http.Request, resp *AuthorizeResponse) { got = resp },
+			func(w http.ResponseWriter, r *http.Request, err error) { t.Fatalf("unexpected error: %v", err) },
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/callback?state=state-1", nil)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, &AuthorizeResponse{Access_token: "qwe-rty-123", Username: "testuser"}, got)
+	})
+
+	t.Run("missing state", func(t *testing.T) {
+		store := NewMemoryStateStore(time.Minute)
+		c := &Client{}
+
+		var gotErr error
+		handler := c.CallbackHandler(store,
+			func(w http.ResponseWriter, r *http.Request, resp *AuthorizeResponse) { t.Fatal("unexpected success") },
+			func(w http.ResponseWriter, r *http.Request, err error) { gotErr = err },
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/callback", nil)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Error(t, gotErr)
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		store := NewMemoryStateStore(time.Minute)
+		c := &Client{}
+
+		var gotErr error
+		handler := c.CallbackHandler(store,
+			func(w http.ResponseWriter, r *http.Request, resp *AuthorizeResponse) { t.Fatal("unexpected success") },
+			func(w http.ResponseWriter, r *http.Request, err error) { gotErr = err },
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/callback?state=unknown", nil)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Error(t, gotErr)
+	})
+}
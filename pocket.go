@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -85,20 +86,96 @@ func (i AddInput) generateRequest(consumerKey string) addRequest {
 type Client struct {
 	client      *http.Client
 	consumerKey string
+	baseURL     string
+	userAgent   string
+	retryPolicy RetryPolicy
+
+	mu            sync.Mutex
+	lastRateLimit RateLimit
+}
+
+// Option configures a Client constructed by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used to perform requests. The retrying
+// transport installed by NewClient wraps whatever Transport is set on it.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.client = httpClient
+	}
+}
+
+// WithTimeout sets the timeout of the underlying http.Client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.client.Timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithBaseURL overrides the Pocket API base URL, mainly useful in tests or behind a proxy.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithRetryPolicy installs a RetryPolicy governing how failed requests are retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
 }
 
-// NewClient creates a new client instance with your app key (to generate key visit https://getpocket.com/developer/apps/)
-func NewClient(consumerKey string) (*Client, error) {
+// NewClient creates a new client instance with your app key (to generate key visit https://getpocket.com/developer/apps/).
+// By default requests are retried on 5xx responses, connection errors and Pocket rate-limit
+// responses; use the With* options to customize that or any other aspect of the client.
+func NewClient(consumerKey string, opts ...Option) (*Client, error) {
 	if consumerKey == "" {
 		return nil, errors.New("consumer key is empty")
 	}
 
-	return &Client{
+	c := &Client{
 		client: &http.Client{
 			Timeout: defaultTimeout,
 		},
 		consumerKey: consumerKey,
-	}, nil
+		baseURL:     host,
+		retryPolicy: DefaultRetryPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.client.Transport = &retryTransport{
+		next:        c.client.Transport,
+		policy:      c.retryPolicy,
+		onRateLimit: c.setLastRateLimit,
+	}
+
+	return c, nil
+}
+
+// LastRateLimit returns the rate-limit information parsed from the most recent response.
+// It is zero-valued until the first request completes.
+func (c *Client) LastRateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lastRateLimit
+}
+
+func (c *Client) setLastRateLimit(rl RateLimit) {
+	c.mu.Lock()
+	c.lastRateLimit = rl
+	c.mu.Unlock()
 }
 
 // GetRequestToken obtains the request token that is used to authorize user in your application
@@ -108,7 +185,12 @@ func (c *Client) GetRequestToken(ctx context.Context, redirectUrl string) (strin
 		RedirectUrl: redirectUrl,
 	}
 
-	values, err := c.doHTTP(ctx, endpointRequestToken, inp)
+	b, err := c.doHTTP(ctx, endpointRequestToken, inp)
+	if err != nil {
+		return "", err
+	}
+
+	values, err := parseFormResponse(b)
 	if err != nil {
 		return "", err
 	}
@@ -120,37 +202,49 @@ func (c *Client) GetRequestToken(ctx context.Context, redirectUrl string) (strin
 	return values.Get("code"), nil
 }
 
-func (c *Client) doHTTP(ctx context.Context, endpoint string, body interface{}) (url.Values, error) {
+// doHTTP sends body as JSON to endpoint and returns the raw response body. Most of the
+// OAuth endpoints still reply with a query-string body, so those callers parse it
+// themselves with url.ParseQuery; JSON endpoints can json.Unmarshal the bytes directly.
+func (c *Client) doHTTP(ctx context.Context, endpoint string, body interface{}) ([]byte, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed with marshal json")
+		return nil, errors.WithMessage(err, "failed with marshal json")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, host+endpoint, bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+endpoint, bytes.NewBuffer(b))
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to send http request")
+		return nil, errors.WithMessage(err, "failed to send http request")
 	}
 
 	req.Header.Set("Content-type", "application/json; charset=UTF8")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to send message request")
+		return nil, errors.WithMessage(err, "failed to send message request")
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err := fmt.Sprintf("API Error: %s", resp.Header.Get(xErrorHeader))
-		return url.Values{}, errors.New(err)
+		limit := parseRateLimit(resp.Header)
+		return nil, fmt.Errorf("pocket: %w", newAPIError(resp, &limit))
 	}
 
 	respB, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to read response body")
+		return nil, errors.WithMessage(err, "failed to read response body")
 	}
 
-	values, err := url.ParseQuery(string(respB))
+	return respB, nil
+}
+
+// parseFormResponse decodes a doHTTP response body that Pocket returned as a query string,
+// which is how the OAuth endpoints reply.
+func parseFormResponse(b []byte) (url.Values, error) {
+	values, err := url.ParseQuery(string(b))
 	if err != nil {
 		return url.Values{}, errors.WithMessage(err, "failed to parse response body")
 	}
@@ -176,7 +270,12 @@ func (c *Client) Authorize(ctx context.Context, requestToken string) (*Authorize
 		ConsumerKey: c.consumerKey,
 	}
 
-	values, err := c.doHTTP(ctx, endpointAuthorize, inp)
+	b, err := c.doHTTP(ctx, endpointAuthorize, inp)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseFormResponse(b)
 	if err != nil {
 		return nil, err
 	}
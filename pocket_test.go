@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,6 +19,10 @@ func (s roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 }
 
 func newClient(t *testing.T, statusCode int, path, body string) *Client {
+	return newClientWithHeaders(t, statusCode, path, body, nil)
+}
+
+func newClientWithHeaders(t *testing.T, statusCode int, path, body string, header http.Header) *Client {
 	return &Client{
 		client: &http.Client{
 			Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
@@ -26,15 +31,39 @@ func newClient(t *testing.T, statusCode int, path, body string) *Client {
 
 				return &http.Response{
 					StatusCode: statusCode,
+					Header:     header,
 					Body:       ioutil.NopCloser(strings.NewReader(body)),
 				}, nil
 
 			}),
 		},
 		consumerKey: "key",
+		baseURL:     host,
 	}
 }
 
+func TestNewClient(t *testing.T) {
+	t.Run("empty consumer key", func(t *testing.T) {
+		c, err := NewClient("")
+		assert.Error(t, err)
+		assert.Nil(t, c)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		c, err := NewClient("key")
+		assert.NoError(t, err)
+		assert.Equal(t, host, c.baseURL)
+		assert.Equal(t, DefaultRetryPolicy(), c.retryPolicy)
+	})
+
+	t.Run("options are applied", func(t *testing.T) {
+		c, err := NewClient("key", WithBaseURL("http://proxy.local/v3"), WithUserAgent("test-agent"))
+		assert.NoError(t, err)
+		assert.Equal(t, "http://proxy.local/v3", c.baseURL)
+		assert.Equal(t, "test-agent", c.userAgent)
+	})
+}
+
 func TestClient_GetRequestTokent(t *testing.T) {
 	type args struct {
 		ctx         context.Context
@@ -231,6 +260,62 @@ func TestClient_Authorize(t *testing.T) {
 	}
 }
 
+// apiErrorCodeCases is the shared fixture for endpoints that surface Pocket's X-Error-Code
+// header as a typed APIError.
+var apiErrorCodeCases = []struct {
+	name    string
+	header  http.Header
+	wantErr error
+}{
+	{
+		name:    "invalid consumer key",
+		header:  http.Header{"X-Error-Code": []string{"132"}, "X-Error": []string{"invalid consumer key"}},
+		wantErr: ErrInvalidConsumerKey,
+	},
+	{
+		name:    "invalid access token",
+		header:  http.Header{"X-Error-Code": []string{"138"}, "X-Error": []string{"invalid access token"}},
+		wantErr: ErrInvalidAccessToken,
+	},
+	{
+		name:    "missing permission",
+		header:  http.Header{"X-Error-Code": []string{"152"}, "X-Error": []string{"missing permission"}},
+		wantErr: ErrMissingPermission,
+	},
+	{
+		name:    "rate limited",
+		header:  http.Header{"X-Error-Code": []string{"199"}, "X-Error": []string{"rate limit exceeded"}},
+		wantErr: ErrRateLimited,
+	},
+}
+
+// assertAPIErrorCodes runs apiErrorCodeCases against path, calling call for each case and
+// asserting that the returned error carries the matching sentinel and APIError details.
+func assertAPIErrorCodes(t *testing.T, path string, call func(c *Client) error) {
+	for _, tt := range apiErrorCodeCases {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClientWithHeaders(t, http.StatusForbidden, path, "", tt.header)
+
+			err := call(c)
+
+			assert.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr))
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+			assert.Equal(t, tt.header.Get("X-Error"), apiErr.Message)
+		})
+	}
+}
+
+func TestClient_Authorize_APIErrorCodes(t *testing.T) {
+	assertAPIErrorCodes(t, "/v3/oauth/authorize", func(c *Client) error {
+		_, err := c.Authorize(context.Background(), "token")
+		return err
+	})
+}
+
 func TestClient_Add(t *testing.T) {
 	type args struct {
 		ctx   context.Context
@@ -325,3 +410,9 @@ func TestClient_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Add_APIErrorCodes(t *testing.T) {
+	assertAPIErrorCodes(t, "/v3/add", func(c *Client) error {
+		return c.Add(context.Background(), AddInput{URL: "http://example.link", AccessToken: "token"})
+	})
+}
@@ -0,0 +1,203 @@
+package pocket
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const endpointRetrieve = "/get"
+
+// State filters items returned by Retrieve by their read/archive status.
+type State string
+
+const (
+	StateUnread  State = "unread"
+	StateArchive State = "archive"
+	StateAll     State = "all"
+)
+
+// ContentType filters items returned by Retrieve by the kind of content they hold.
+type ContentType string
+
+const (
+	ContentTypeArticle ContentType = "article"
+	ContentTypeVideo   ContentType = "video"
+	ContentTypeImage   ContentType = "image"
+)
+
+// Sort controls the order in which Retrieve returns items.
+type Sort string
+
+const (
+	SortNewest Sort = "newest"
+	SortOldest Sort = "oldest"
+	SortTitle  Sort = "title"
+	SortSite   Sort = "site"
+)
+
+// DetailType controls how much data Retrieve returns for each item.
+type DetailType string
+
+const (
+	DetailTypeSimple   DetailType = "simple"
+	DetailTypeComplete DetailType = "complete"
+)
+
+// RetrieveInput holds the filtering, sorting and pagination options for Retrieve.
+type RetrieveInput struct {
+	AccessToken string
+	State       State
+	Favorite    *bool
+	Tag         string
+	ContentType ContentType
+	Sort        Sort
+	DetailType  DetailType
+	Search      string
+	Domain      string
+	Since       *time.Time
+	Count       int
+	Offset      int
+}
+
+func (i RetrieveInput) validate() error {
+	if i.AccessToken == "" {
+		return errors.New("access token is empty")
+	}
+
+	return nil
+}
+
+type retrieveRequest struct {
+	ConsumerKey string      `json:"consumer_key"`
+	AccessToken string      `json:"access_token"`
+	State       State       `json:"state,omitempty"`
+	Favorite    *int        `json:"favorite,omitempty"`
+	Tag         string      `json:"tag,omitempty"`
+	ContentType ContentType `json:"contentType,omitempty"`
+	Sort        Sort        `json:"sort,omitempty"`
+	DetailType  DetailType  `json:"detailType,omitempty"`
+	Search      string      `json:"search,omitempty"`
+	Domain      string      `json:"domain,omitempty"`
+	Since       int64       `json:"since,omitempty"`
+	Count       int         `json:"count,omitempty"`
+	Offset      int         `json:"offset,omitempty"`
+}
+
+func (i RetrieveInput) generateRequest(consumerKey string) retrieveRequest {
+	req := retrieveRequest{
+		ConsumerKey: consumerKey,
+		AccessToken: i.AccessToken,
+		State:       i.State,
+		Tag:         i.Tag,
+		ContentType: i.ContentType,
+		Sort:        i.Sort,
+		DetailType:  i.DetailType,
+		Search:      i.Search,
+		Domain:      i.Domain,
+		Count:       i.Count,
+		Offset:      i.Offset,
+	}
+
+	if i.Favorite != nil {
+		fav := 0
+		if *i.Favorite {
+			fav = 1
+		}
+		req.Favorite = &fav
+	}
+
+	if i.Since != nil {
+		req.Since = i.Since.Unix()
+	}
+
+	return req
+}
+
+// RetrieveResponse is the decoded result of a call to Retrieve.
+type RetrieveResponse struct {
+	Status   int                   `json:"status"`
+	Complete FlexBool              `json:"complete"`
+	List     map[string]PocketItem `json:"list"`
+	Since    int                   `json:"since"`
+}
+
+// PocketItem represents a single saved item as returned by Retrieve. Pocket encodes most
+// numeric and boolean fields as JSON strings, hence the Flex* field types.
+type PocketItem struct {
+	ItemID        FlexInt           `json:"item_id"`
+	ResolvedID    FlexInt           `json:"resolved_id"`
+	GivenURL      string            `json:"given_url"`
+	ResolvedURL   string            `json:"resolved_url"`
+	GivenTitle    string            `json:"given_title"`
+	ResolvedTitle string            `json:"resolved_title"`
+	Favorite      FlexBool          `json:"favorite"`
+	Status        FlexInt           `json:"status"`
+	Excerpt       string            `json:"excerpt"`
+	IsArticle     FlexBool          `json:"is_article"`
+	HasImage      FlexInt           `json:"has_image"`
+	HasVideo      FlexInt           `json:"has_video"`
+	WordCount     FlexInt           `json:"word_count"`
+	TimeAdded     FlexTime          `json:"time_added"`
+	TimeUpdated   FlexTime          `json:"time_updated"`
+	TimeRead      FlexTime          `json:"time_read"`
+	TimeFavorited FlexTime          `json:"time_favorited"`
+	Tags          map[string]Tag    `json:"tags"`
+	Authors       map[string]Author `json:"authors"`
+	Images        map[string]Image  `json:"images"`
+	Videos        map[string]Video  `json:"videos"`
+}
+
+// Tag describes a single tag attached to a PocketItem.
+type Tag struct {
+	ItemID FlexInt `json:"item_id"`
+	Tag    string  `json:"tag"`
+}
+
+// Author describes a single author attributed to a PocketItem.
+type Author struct {
+	AuthorID FlexInt `json:"author_id"`
+	Name     string  `json:"name"`
+	URL      string  `json:"url"`
+}
+
+// Image describes a single image attached to a PocketItem.
+type Image struct {
+	ImageID FlexInt `json:"image_id"`
+	Src     string  `json:"src"`
+	Width   FlexInt `json:"width"`
+	Height  FlexInt `json:"height"`
+}
+
+// Video describes a single video attached to a PocketItem.
+type Video struct {
+	VideoID FlexInt `json:"video_id"`
+	Src     string  `json:"src"`
+	Width   FlexInt `json:"width"`
+	Height  FlexInt `json:"height"`
+	Type    FlexInt `json:"type"`
+}
+
+// Retrieve fetches saved items from the user's Pocket list, with optional filtering,
+// sorting and pagination.
+func (c *Client) Retrieve(ctx context.Context, input RetrieveInput) (*RetrieveResponse, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	req := input.generateRequest(c.consumerKey)
+
+	b, err := c.doHTTP(ctx, endpointRetrieve, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp RetrieveResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, errors.WithMessage(err, "failed to parse response body")
+	}
+
+	return &resp, nil
+}
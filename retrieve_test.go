@@ -0,0 +1,99 @@
+package pocket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Retrieve(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input RetrieveInput
+	}
+	tests := []struct {
+		name               string
+		args               args
+		expectedStatusCode int
+		expectedResponse   string
+		want               *RetrieveResponse
+		wantErr            bool
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: context.Background(),
+				input: RetrieveInput{
+					AccessToken: "token",
+					State:       StateUnread,
+				},
+			},
+			expectedStatusCode: 200,
+			expectedResponse: `{
+				"status": 1,
+				"complete": "1",
+				"since": 1600000000,
+				"list": {
+					"123": {
+						"item_id": "123",
+						"given_url": "http://example.link",
+						"resolved_title": "Example",
+						"favorite": "0",
+						"word_count": "42",
+						"time_added": "1600000000"
+					}
+				}
+			}`,
+			want: &RetrieveResponse{
+				Status:   1,
+				Complete: true,
+				Since:    1600000000,
+				List: map[string]PocketItem{
+					"123": {
+						ItemID:        123,
+						GivenURL:      "http://example.link",
+						ResolvedTitle: "Example",
+						Favorite:      false,
+						WordCount:     42,
+						TimeAdded:     FlexTime{time.Unix(1600000000, 0).UTC()},
+					},
+				},
+			},
+		},
+		{
+			name: "Empty access token",
+			args: args{
+				ctx:   context.Background(),
+				input: RetrieveInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Non-2XX-Response",
+			args: args{
+				ctx: context.Background(),
+				input: RetrieveInput{
+					AccessToken: "token",
+				},
+			},
+			expectedStatusCode: 400,
+			wantErr:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, tt.expectedStatusCode, "/v3/get", tt.expectedResponse)
+
+			got, err := c.Retrieve(tt.args.ctx, tt.args.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package pocket
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerUserRemaining = "X-Limit-User-Remaining"
+	headerUserReset     = "X-Limit-User-Reset"
+	headerKeyRemaining  = "X-Limit-Key-Remaining"
+	headerKeyReset      = "X-Limit-Key-Reset"
+)
+
+// RateLimit captures Pocket's per-request rate-limit headers. Remaining fields are -1
+// when the corresponding header was absent from the response.
+type RateLimit struct {
+	UserRemaining int
+	UserReset     time.Time
+	KeyRemaining  int
+	KeyReset      time.Time
+}
+
+// laterReset returns whichever of UserReset/KeyReset is further in the future, so a
+// caller waiting out a rate limit doesn't wake up before the limit that actually tripped
+// has reset.
+func (rl RateLimit) laterReset() time.Time {
+	if rl.KeyReset.After(rl.UserReset) {
+		return rl.KeyReset
+	}
+
+	return rl.UserReset
+}
+
+// RetryPolicy controls how the client retries failed requests.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts made after the first one.
+	MaxRetries int
+	// MinBackoff is the base delay before the first retry; it doubles on each
+	// subsequent attempt up to MaxBackoff.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is supplied via
+// WithRetryPolicy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// retryTransport is an http.RoundTripper that retries on 5xx responses and connection
+// errors, with exponential backoff and jitter. A 403 response that reports zero remaining
+// user or key requests is treated as a Pocket rate limit: it sleeps until the later reset
+// time and then retries. Any other status below 500, including a 403 without a zero
+// remaining count, is returned without a retry.
+type retryTransport struct {
+	next        http.RoundTripper
+	policy      RetryPolicy
+	onRateLimit func(RateLimit)
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.next
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= t.policy.MaxRetries; attempt++ {
+		reqAttempt := req
+		if attempt > 0 {
+			if req.GetBody == nil {
+				break
+			}
+
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+
+			reqAttempt = req.Clone(req.Context())
+			reqAttempt.Body = body
+		}
+
+		resp, err = transport.RoundTrip(reqAttempt)
+		if err != nil {
+			if attempt == t.policy.MaxRetries {
+				return nil, err
+			}
+			if waitErr := sleepContext(req.Context(), t.policy.backoff(attempt)); waitErr != nil {
+				return nil, waitErr
+			}
+			continue
+		}
+
+		rl := parseRateLimit(resp.Header)
+		if t.onRateLimit != nil {
+			t.onRateLimit(rl)
+		}
+
+		if resp.StatusCode == http.StatusForbidden && (rl.UserRemaining == 0 || rl.KeyRemaining == 0) {
+			resp.Body.Close()
+			if attempt == t.policy.MaxRetries {
+				return resp, nil
+			}
+			if waitErr := sleepContext(req.Context(), time.Until(rl.laterReset())); waitErr != nil {
+				return nil, waitErr
+			}
+			continue
+		}
+
+		if resp.StatusCode < http.StatusInternalServerError {
+			return resp, nil
+		}
+
+		if attempt == t.policy.MaxRetries {
+			return resp, nil
+		}
+
+		resp.Body.Close()
+		if waitErr := sleepContext(req.Context(), t.policy.backoff(attempt)); waitErr != nil {
+			return nil, waitErr
+		}
+	}
+
+	return resp, err
+}
+
+// backoff returns the delay before the attempt-th retry, applying exponential growth
+// bounded by MaxBackoff and up to 50% jitter.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff << uint(attempt)
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(d) + 1))
+
+	return d/2 + jitter/2
+}
+
+func parseRateLimit(h http.Header) RateLimit {
+	rl := RateLimit{UserRemaining: -1, KeyRemaining: -1}
+
+	if v, err := strconv.Atoi(h.Get(headerUserRemaining)); err == nil {
+		rl.UserRemaining = v
+	}
+
+	if v, err := strconv.Atoi(h.Get(headerUserReset)); err == nil {
+		rl.UserReset = time.Now().Add(time.Duration(v) * time.Second)
+	}
+
+	if v, err := strconv.Atoi(h.Get(headerKeyRemaining)); err == nil {
+		rl.KeyRemaining = v
+	}
+
+	if v, err := strconv.Atoi(h.Get(headerKeyReset)); err == nil {
+		rl.KeyReset = time.Now().Add(time.Duration(v) * time.Second)
+	}
+
+	return rl
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
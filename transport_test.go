@@ -0,0 +1,131 @@
+package pocket
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport_RetriesOn5xx(t *testing.T) {
+	var calls int
+
+	next := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		if calls < 3 {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("ok")), Header: http.Header{}}, nil
+	})
+
+	rt := &retryTransport{
+		next:   next,
+		policy: RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://example.link", strings.NewReader(""))
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryTransport_RateLimitWaitsForReset(t *testing.T) {
+	var calls int
+	var seenLimits []RateLimit
+
+	next := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			header := http.Header{}
+			header.Set("X-Limit-User-Remaining", "0")
+			header.Set("X-Limit-User-Reset", "0")
+
+			return &http.Response{StatusCode: http.StatusForbidden, Body: ioutil.NopCloser(strings.NewReader("")), Header: header}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("ok")), Header: http.Header{}}, nil
+	})
+
+	rt := &retryTransport{
+		next:        next,
+		policy:      RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		onRateLimit: func(rl RateLimit) { seenLimits = append(seenLimits, rl) },
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://example.link", strings.NewReader(""))
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 0, seenLimits[0].UserRemaining)
+}
+
+func TestRetryTransport_KeyRateLimitWaitsForReset(t *testing.T) {
+	var calls int
+	var seenLimits []RateLimit
+
+	next := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			header := http.Header{}
+			header.Set("X-Limit-User-Remaining", "100")
+			header.Set("X-Limit-Key-Remaining", "0")
+			header.Set("X-Limit-Key-Reset", "0")
+
+			return &http.Response{StatusCode: http.StatusForbidden, Body: ioutil.NopCloser(strings.NewReader("")), Header: header}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("ok")), Header: http.Header{}}, nil
+	})
+
+	rt := &retryTransport{
+		next:        next,
+		policy:      RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		onRateLimit: func(rl RateLimit) { seenLimits = append(seenLimits, rl) },
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://example.link", strings.NewReader(""))
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 0, seenLimits[0].KeyRemaining)
+}
+
+func TestRetryTransport_GivesUpAfterMaxRetries(t *testing.T) {
+	var calls int
+
+	next := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+	})
+
+	rt := &retryTransport{
+		next:   next,
+		policy: RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://example.link", strings.NewReader(""))
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
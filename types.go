@@ -0,0 +1,68 @@
+package pocket
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FlexInt is an int that unmarshals from either a JSON number or a numeric JSON string,
+// which is how Pocket encodes most integer fields in its responses.
+type FlexInt int
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *FlexInt) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+
+	*n = FlexInt(v)
+
+	return nil
+}
+
+// FlexBool is a bool that unmarshals from either a JSON boolean or Pocket's "0"/"1" string
+// encoding.
+type FlexBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "null" {
+		s = ""
+	}
+	*b = s == "1" || s == "true"
+
+	return nil
+}
+
+// FlexTime is a time.Time that unmarshals from Pocket's Unix timestamp strings. A zero or
+// empty value decodes to the zero time.Time.
+type FlexTime struct {
+	time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *FlexTime) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "0" || s == "null" {
+		*t = FlexTime{}
+		return nil
+	}
+
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*t = FlexTime{time.Unix(sec, 0).UTC()}
+
+	return nil
+}
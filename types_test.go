@@ -0,0 +1,90 @@
+package pocket
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexInt_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    FlexInt
+		wantErr bool
+	}{
+		{name: "quoted number", input: `"42"`, want: 42},
+		{name: "unquoted number", input: `42`, want: 42},
+		{name: "empty string", input: `""`, want: 0},
+		{name: "null", input: `null`, want: 0},
+		{name: "not a number", input: `"nope"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got FlexInt
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFlexBool_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  FlexBool
+	}{
+		{name: "quoted one", input: `"1"`, want: true},
+		{name: "quoted zero", input: `"0"`, want: false},
+		{name: "bool true", input: `true`, want: true},
+		{name: "bool false", input: `false`, want: false},
+		{name: "empty string", input: `""`, want: false},
+		{name: "null", input: `null`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got FlexBool
+			err := json.Unmarshal([]byte(tt.input), &got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFlexTime_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    FlexTime
+		wantErr bool
+	}{
+		{name: "quoted timestamp", input: `"1600000000"`, want: FlexTime{time.Unix(1600000000, 0).UTC()}},
+		{name: "unquoted timestamp", input: `1600000000`, want: FlexTime{time.Unix(1600000000, 0).UTC()}},
+		{name: "zero", input: `"0"`, want: FlexTime{}},
+		{name: "empty string", input: `""`, want: FlexTime{}},
+		{name: "null", input: `null`, want: FlexTime{}},
+		{name: "not a number", input: `"nope"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got FlexTime
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, tt.want.Equal(got.Time))
+			}
+		})
+	}
+}